@@ -0,0 +1,100 @@
+package api
+
+import (
+	"fmt"
+	"time"
+)
+
+// Gist represents a GitHub gist, as returned by the Gists API.
+type Gist struct {
+	ID          string               `json:"id"`
+	Description string               `json:"description"`
+	Public      bool                 `json:"public"`
+	HTMLURL     string               `json:"html_url"`
+	GitPullURL  string               `json:"git_pull_url"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+	Files       map[string]*GistFile `json:"files"`
+}
+
+// GistFile represents a single file within a gist.
+type GistFile struct {
+	Filename string `json:"filename,omitempty"`
+	Content  string `json:"content,omitempty"`
+	Language string `json:"language,omitempty"`
+}
+
+type gistFilesParams map[string]*GistFile
+
+type gistCreateParams struct {
+	Description string          `json:"description"`
+	Public      bool            `json:"public"`
+	Files       gistFilesParams `json:"files"`
+}
+
+// GistCreate creates a new gist containing the given files.
+func GistCreate(client *Client, description string, public bool, files map[string]*GistFile) (*Gist, error) {
+	params := gistCreateParams{
+		Description: description,
+		Public:      public,
+		Files:       files,
+	}
+
+	result := Gist{}
+	err := client.REST("POST", "gists", params, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GistList fetches one page of the gists belonging to the authenticated
+// user, newest first. The Gists API has no way to filter by visibility
+// server-side, so callers that need to filter should page through results
+// themselves rather than relying on perPage to bound the filtered count.
+func GistList(client *Client, page, perPage int) ([]Gist, error) {
+	var gists []Gist
+	err := client.REST("GET", fmt.Sprintf("gists?per_page=%d&page=%d", perPage, page), nil, &gists)
+	if err != nil {
+		return nil, err
+	}
+	return gists, nil
+}
+
+// GistGet fetches a single gist by ID.
+func GistGet(client *Client, gistID string) (*Gist, error) {
+	result := Gist{}
+	err := client.REST("GET", fmt.Sprintf("gists/%s", gistID), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+type gistUpdateParams struct {
+	Description string          `json:"description,omitempty"`
+	Files       gistFilesParams `json:"files"`
+}
+
+// GistUpdate patches a gist's description and/or files. To delete an
+// existing file, set its map entry to a nil *GistFile rather than a
+// &GistFile{}: the Gists API deletes a file when its JSON value is `null`,
+// and gistFilesParams serializes a nil pointer that way.
+func GistUpdate(client *Client, gistID string, description string, files map[string]*GistFile) (*Gist, error) {
+	params := gistUpdateParams{
+		Description: description,
+		Files:       files,
+	}
+
+	result := Gist{}
+	err := client.REST("PATCH", fmt.Sprintf("gists/%s", gistID), params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GistDelete deletes a gist by ID.
+func GistDelete(client *Client, gistID string) error {
+	return client.REST("DELETE", fmt.Sprintf("gists/%s", gistID), nil, nil)
+}
@@ -1,25 +1,38 @@
 package command
 
 import (
-	"errors"
 	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path"
+	"regexp"
 
-	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/api"
-	"github.com/cli/cli/pkg/cmdutil"
-	"github.com/cli/cli/utils"
 	"github.com/spf13/cobra"
 )
 
 func init() {
 	RootCmd.AddCommand(gistCmd)
 	gistCmd.AddCommand(gistCreateCmd)
+	gistCmd.AddCommand(gistListCmd)
+	gistCmd.AddCommand(gistViewCmd)
+	gistCmd.AddCommand(gistEditCmd)
+	gistCmd.AddCommand(gistDeleteCmd)
+	gistCmd.AddCommand(gistCloneCmd)
+
 	gistCreateCmd.Flags().StringP("desc", "d", "", "A description for this gist")
 	gistCreateCmd.Flags().BoolP("public", "p", false, "List the gist publicly (default: private)")
+	gistCreateCmd.Flags().String("manifest", "", "Describe the gist to create as a YAML or JSON file instead of positional arguments")
+	gistCreateCmd.Flags().BoolP("web", "w", false, "Open the gist in the browser after creating it")
+	gistCreateCmd.Flags().BoolP("clipboard", "c", false, "Copy the gist's URL to the clipboard after creating it")
+	gistCreateCmd.Flags().Bool("short", false, "Also print a shortened URL for the gist")
+
+	gistListCmd.Flags().IntP("limit", "L", 10, "Maximum number of gists to fetch")
+	gistListCmd.Flags().BoolP("public", "p", false, "Show only public gists")
+	gistListCmd.Flags().BoolP("secret", "s", false, "Show only secret gists")
+
+	gistViewCmd.Flags().BoolP("raw", "r", false, "Print the file content without the TTY filename headers, for piping")
+
+	gistEditCmd.Flags().StringArray("add", nil, "Add a local file to the gist")
+	gistEditCmd.Flags().StringArray("remove", nil, "Remove a file from the gist")
+	gistEditCmd.Flags().StringP("desc", "d", "", "New description for the gist")
 }
 
 var gistCmd = &cobra.Command{
@@ -28,148 +41,22 @@ var gistCmd = &cobra.Command{
 	Long:  `Work with GitHub gists.`,
 }
 
-var gistCreateCmd = &cobra.Command{
-	Use:   `create [<filename>... | -]`,
-	Short: "Create a new gist",
-	Long: `Create a new GitHub gist with given contents.
-
-Gists can be created from one or multiple files. Alternatively, pass "-" as
-file name to read from standard input.
-
-By default, gists are private; use '--public' to make publicly listed ones.`,
-	Example: heredoc.Doc(`
-	# publish file 'hello.py' as a public gist
-	$ gh gist create --public hello.py
-	
-	# create a gist with a description
-	$ gh gist create hello.py -d "my Hello-World program in Python"
-
-	# create a gist containing several files
-	$ gh gist create hello.py world.py cool.txt
-	
-	# read from standard input to create a gist
-	$ gh gist create -
-	
-	# create a gist from output piped from another command
-	$ cat cool.txt | gh gist create
-	`),
-	Args: func(cmd *cobra.Command, args []string) error {
-		if len(args) > 0 {
-			return nil
-		}
-
-		info, err := os.Stdin.Stat()
-		if err != nil {
-			return fmt.Errorf("failed to check STDIN: %w", err)
-		}
-
-		stdinIsTTY := (info.Mode() & os.ModeCharDevice) == os.ModeCharDevice
-		if stdinIsTTY {
-			return &cmdutil.FlagError{Err: errors.New("no filenames passed and nothing on STDIN")}
-		}
-		return nil
-	},
-	RunE: gistCreate,
-}
-
-type Opts struct {
-	Description string
-	Public      bool
-}
-
-func gistCreate(cmd *cobra.Command, args []string) error {
-	ctx := contextForCommand(cmd)
-	client, err := apiClientForContext(ctx)
-	if err != nil {
-		return err
-	}
+var gistURLRE = regexp.MustCompile(`^https://gist\.github\.com/(?:[^/]+/)?([a-fA-F0-9]+)`)
 
-	// This performs a dummy query, checks what scopes we have, and then asks for a user to reauth
-	// with expanded scopes. it introduces latency whenever this command is run: a trade-off to avoid
-	// having every single user reauth as a result of this feature even if they never once use gists.
-	//
-	// In the future we'd rather have the ability to detect a "reauth needed" scenario and replay
-	// failed requests but some short spikes indicated that that would be a fair bit of work.
-	client, err = ensureScopes(ctx, client, "gist")
-	if err != nil {
-		return err
+// gistIDFromArg accepts either a bare gist ID or a gist.github.com URL and
+// returns the ID, so that every gist subcommand can take either form.
+func gistIDFromArg(arg string) string {
+	if m := gistURLRE.FindStringSubmatch(arg); m != nil {
+		return m[1]
 	}
-
-	opts, err := processOpts(cmd)
-	if err != nil {
-		return fmt.Errorf("did not understand arguments: %w", err)
-	}
-
-	fileArgs := args
-	if len(args) == 0 {
-		fileArgs = []string{"-"}
-	}
-
-	files, err := processFiles(os.Stdin, fileArgs)
-	if err != nil {
-		return fmt.Errorf("failed to collect files for posting: %w", err)
-	}
-
-	errOut := colorableErr(cmd)
-	fmt.Fprintf(errOut, "%s Creating gist...\n", utils.Gray("-"))
-
-	gist, err := api.GistCreate(client, opts.Description, opts.Public, files)
-	if err != nil {
-		return fmt.Errorf("%s Failed to create gist: %w", utils.Red("X"), err)
-	}
-
-	fmt.Fprintf(errOut, "%s Created gist\n", utils.Green("✓"))
-
-	fmt.Fprintln(cmd.OutOrStdout(), gist.HTMLURL)
-
-	return nil
+	return arg
 }
 
-func processOpts(cmd *cobra.Command) (*Opts, error) {
-	description, err := cmd.Flags().GetString("desc")
+func resolveGist(client *api.Client, arg string) (*api.Gist, error) {
+	gistID := gistIDFromArg(arg)
+	gist, err := api.GistGet(client, gistID)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("could not find gist %s: %w", gistID, err)
 	}
-
-	public, err := cmd.Flags().GetBool("public")
-	if err != nil {
-		return nil, err
-	}
-
-	return &Opts{
-		Description: description,
-		Public:      public,
-	}, err
-}
-
-func processFiles(stdin io.ReadCloser, filenames []string) (map[string]string, error) {
-	fs := map[string]string{}
-
-	if len(filenames) == 0 {
-		return nil, errors.New("no files passed")
-	}
-
-	for i, f := range filenames {
-		var filename string
-		var content []byte
-		var err error
-		if f == "-" {
-			filename = fmt.Sprintf("gistfile%d.txt", i)
-			content, err = ioutil.ReadAll(stdin)
-			if err != nil {
-				return fs, fmt.Errorf("failed to read from stdin: %w", err)
-			}
-			stdin.Close()
-		} else {
-			content, err = ioutil.ReadFile(f)
-			if err != nil {
-				return fs, fmt.Errorf("failed to read file %s: %w", f, err)
-			}
-			filename = path.Base(f)
-		}
-
-		fs[filename] = string(content)
-	}
-
-	return fs, nil
+	return gist, nil
 }
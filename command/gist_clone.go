@@ -0,0 +1,38 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/git"
+	"github.com/spf13/cobra"
+)
+
+var gistCloneCmd = &cobra.Command{
+	Use:   "clone {<id> | <url>} [<directory>]",
+	Short: "Clone a gist locally",
+	Long: `Clone a gist locally as a git repository.
+
+Additional 'git clone' flags can be passed after the gist argument, the same
+way they can with 'gh repo clone'.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: gistClone,
+}
+
+func gistClone(cmd *cobra.Command, args []string) error {
+	ctx := contextForCommand(cmd)
+	client, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	gist, err := resolveGist(client, args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := git.RunClone(gist.GitPullURL, args[1:]); err != nil {
+		return fmt.Errorf("failed to clone gist %s: %w", gist.ID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,356 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"runtime"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/shorturl"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var gistCreateCmd = &cobra.Command{
+	Use:   `create [<filename>... | -]`,
+	Short: "Create a new gist",
+	Long: `Create a new GitHub gist with given contents.
+
+Gists can be created from one or multiple files. Alternatively, pass "-" as
+file name to read from standard input.
+
+A file name can also take the form "rev:path" (e.g. "HEAD~3:main.go") to read
+that file's contents as of a past git revision rather than the working tree;
+prefix it with "name=" to control the resulting gist filename.
+
+By default, gists are private; use '--public' to make publicly listed ones.`,
+	Example: heredoc.Doc(`
+	# publish file 'hello.py' as a public gist
+	$ gh gist create --public hello.py
+
+	# create a gist with a description
+	$ gh gist create hello.py -d "my Hello-World program in Python"
+
+	# create a gist containing several files
+	$ gh gist create hello.py world.py cool.txt
+
+	# read from standard input to create a gist
+	$ gh gist create -
+
+	# create a gist from output piped from another command
+	$ cat cool.txt | gh gist create
+
+	# create a gist from a manifest describing several files at once
+	$ gh gist create --manifest gist.yml
+
+	# snapshot a file as it looked 3 commits ago
+	$ gh gist create HEAD~3:main.go
+	`),
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) > 0 {
+			return nil
+		}
+
+		if manifest, _ := cmd.Flags().GetString("manifest"); manifest != "" {
+			return nil
+		}
+
+		info, err := os.Stdin.Stat()
+		if err != nil {
+			return fmt.Errorf("failed to check STDIN: %w", err)
+		}
+
+		stdinIsTTY := (info.Mode() & os.ModeCharDevice) == os.ModeCharDevice
+		if stdinIsTTY {
+			return &cmdutil.FlagError{Err: errors.New("no filenames passed and nothing on STDIN")}
+		}
+		return nil
+	},
+	RunE: gistCreate,
+}
+
+type Opts struct {
+	Description string
+	Public      bool
+}
+
+// FileSpec describes a single file to upload as part of a gist, whether it
+// came from a positional argument, stdin, or a --manifest entry.
+type FileSpec struct {
+	Path     string // local path to read, or "-" for stdin; ignored when Content is already set
+	Rev      string // if non-empty, Path is read from this git revision instead of the working tree
+	Name     string // filename to use in the gist; inferred from Path when empty
+	Language string // sent as the file's language; Name and its extension are left unchanged
+	Content  string // inline content; when set, Path is never read
+}
+
+func gistCreate(cmd *cobra.Command, args []string) error {
+	ctx := contextForCommand(cmd)
+	client, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// This performs a dummy query, checks what scopes we have, and then asks for a user to reauth
+	// with expanded scopes. it introduces latency whenever this command is run: a trade-off to avoid
+	// having every single user reauth as a result of this feature even if they never once use gists.
+	//
+	// In the future we'd rather have the ability to detect a "reauth needed" scenario and replay
+	// failed requests but some short spikes indicated that that would be a fair bit of work.
+	client, err = ensureScopes(ctx, client, "gist")
+	if err != nil {
+		return err
+	}
+
+	opts, err := processOpts(cmd)
+	if err != nil {
+		return fmt.Errorf("did not understand arguments: %w", err)
+	}
+
+	manifestPath, err := cmd.Flags().GetString("manifest")
+	if err != nil {
+		return err
+	}
+
+	var specs []FileSpec
+	if manifestPath != "" {
+		opts, specs, err = loadManifest(manifestPath, opts)
+		if err != nil {
+			return fmt.Errorf("failed to read manifest %s: %w", manifestPath, err)
+		}
+	} else {
+		fileArgs := args
+		if len(args) == 0 {
+			fileArgs = []string{"-"}
+		}
+		specs = make([]FileSpec, len(fileArgs))
+		for i, f := range fileArgs {
+			specs[i] = parsePositionalFileArg(f)
+		}
+	}
+
+	specs, err = resolveFileSpecs(os.Stdin, specs)
+	if err != nil {
+		return fmt.Errorf("failed to collect files for posting: %w", err)
+	}
+
+	files := map[string]*api.GistFile{}
+	for _, spec := range specs {
+		files[spec.Name] = &api.GistFile{Content: spec.Content, Language: spec.Language}
+	}
+
+	errOut := colorableErr(cmd)
+	fmt.Fprintf(errOut, "%s Creating gist...\n", utils.Gray("-"))
+
+	gist, err := api.GistCreate(client, opts.Description, opts.Public, files)
+	if err != nil {
+		return fmt.Errorf("%s Failed to create gist: %w", utils.Red("X"), err)
+	}
+
+	fmt.Fprintf(errOut, "%s Created gist\n", utils.Green("✓"))
+
+	printURL := gist.HTMLURL
+
+	if short, err := cmd.Flags().GetBool("short"); err != nil {
+		return err
+	} else if short {
+		shortURL, err := shortenGistURL(cmd, gist.HTMLURL)
+		if err != nil {
+			fmt.Fprintf(errOut, "%s Failed to shorten URL: %s\n", utils.Yellow("!"), err)
+		} else {
+			printURL = fmt.Sprintf("%s %s", gist.HTMLURL, shortURL)
+		}
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), printURL)
+
+	if toClipboard, err := cmd.Flags().GetBool("clipboard"); err != nil {
+		return err
+	} else if toClipboard {
+		if err := copyToClipboard(gist.HTMLURL); err != nil {
+			fmt.Fprintf(errOut, "%s Failed to copy URL to clipboard: %s\n", utils.Yellow("!"), err)
+		}
+	}
+
+	if openWeb, err := cmd.Flags().GetBool("web"); err != nil {
+		return err
+	} else if openWeb {
+		fmt.Fprintf(errOut, "Opening %s in your browser.\n", gist.HTMLURL)
+		if err := utils.OpenInBrowser(gist.HTMLURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// shortenGistURL shortens longURL using the shortener named by the
+// `gist_shortener` config setting (defaulting to git.io), optionally
+// pointed at a self-hosted endpoint via `gist_shortener_endpoint`.
+func shortenGistURL(cmd *cobra.Command, longURL string) (string, error) {
+	cfg, err := contextForCommand(cmd).Config()
+	if err != nil {
+		return "", err
+	}
+
+	name, _ := cfg.Get("", "gist_shortener")
+	endpoint, _ := cfg.Get("", "gist_shortener_endpoint")
+
+	shortener, err := shorturl.New(name, endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	return shortener.Shorten(longURL)
+}
+
+// copyToClipboard copies text to the system clipboard with one utility chosen
+// by platform: pbcopy on macOS, clip.exe on Windows, wl-copy when
+// WAYLAND_DISPLAY is set, and xclip otherwise. If that utility fails, no other
+// one is tried.
+func copyToClipboard(text string) error {
+	var copyCmd *exec.Cmd
+	switch {
+	case runtime.GOOS == "darwin":
+		copyCmd = exec.Command("pbcopy")
+	case runtime.GOOS == "windows":
+		copyCmd = exec.Command("clip.exe")
+	case os.Getenv("WAYLAND_DISPLAY") != "":
+		copyCmd = exec.Command("wl-copy")
+	default:
+		copyCmd = exec.Command("xclip", "-selection", "clipboard")
+	}
+
+	copyCmd.Stdin = strings.NewReader(text)
+	return copyCmd.Run()
+}
+
+func processOpts(cmd *cobra.Command) (*Opts, error) {
+	description, err := cmd.Flags().GetString("desc")
+	if err != nil {
+		return nil, err
+	}
+
+	public, err := cmd.Flags().GetBool("public")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Opts{
+		Description: description,
+		Public:      public,
+	}, err
+}
+
+// gistManifest is the shape accepted by --manifest, as YAML or JSON (YAML is
+// a superset of JSON, so both parse the same way).
+type gistManifest struct {
+	Description string `yaml:"description"`
+	Public      bool   `yaml:"public"`
+	Files       []struct {
+		Path     string `yaml:"path"`
+		Name     string `yaml:"name"`
+		Language string `yaml:"language"`
+		Content  string `yaml:"content"`
+	} `yaml:"files"`
+}
+
+// loadManifest reads a --manifest file and turns it into the same Opts and
+// []FileSpec shape the positional-argument path builds, so both flows share
+// resolveFileSpecs and the upload in gistCreate.
+func loadManifest(manifestPath string, fallback *Opts) (*Opts, []FileSpec, error) {
+	raw, err := ioutil.ReadFile(manifestPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var m gistManifest
+	if err := yaml.Unmarshal(raw, &m); err != nil {
+		return nil, nil, err
+	}
+
+	if len(m.Files) == 0 {
+		return nil, nil, errors.New("manifest does not declare any files")
+	}
+
+	opts := &Opts{
+		Description: fallback.Description,
+		Public:      fallback.Public || m.Public,
+	}
+	if m.Description != "" {
+		opts.Description = m.Description
+	}
+
+	specs := make([]FileSpec, len(m.Files))
+	for i, f := range m.Files {
+		specs[i] = FileSpec{
+			Path:     f.Path,
+			Name:     f.Name,
+			Language: f.Language,
+			Content:  f.Content,
+		}
+	}
+
+	return opts, specs, nil
+}
+
+// resolveFileSpecs reads the content for every spec that doesn't already
+// carry inline Content (from a manifest), assigning a gist filename along
+// the way.
+func resolveFileSpecs(stdin io.ReadCloser, specs []FileSpec) ([]FileSpec, error) {
+	if len(specs) == 0 {
+		return nil, errors.New("no files passed")
+	}
+
+	if err := resolveGitRevSpecs(specs); err != nil {
+		return nil, err
+	}
+
+	for i := range specs {
+		spec := &specs[i]
+		if spec.Content != "" {
+			if spec.Name == "" {
+				return nil, fmt.Errorf("a name is required for manifest entry with inline content")
+			}
+			continue
+		}
+		if spec.Rev != "" {
+			// resolveGitRevSpecs already populated Content and Name above.
+			continue
+		}
+
+		var content []byte
+		var err error
+		if spec.Path == "-" {
+			content, err = ioutil.ReadAll(stdin)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read from stdin: %w", err)
+			}
+			stdin.Close()
+			if spec.Name == "" {
+				spec.Name = fmt.Sprintf("gistfile%d.txt", i)
+			}
+		} else {
+			content, err = ioutil.ReadFile(spec.Path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read file %s: %w", spec.Path, err)
+			}
+			if spec.Name == "" {
+				spec.Name = path.Base(spec.Path)
+			}
+		}
+
+		spec.Content = string(content)
+	}
+
+	return specs, nil
+}
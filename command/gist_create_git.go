@@ -0,0 +1,160 @@
+package command
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os/exec"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// parsePositionalFileArg turns one positional argument to `gh gist create`
+// into a FileSpec. Besides a plain path (or "-" for stdin), it recognizes
+// "rev:path" to read the file from a git revision instead of the working
+// tree, optionally prefixed with "name=" to override the gist filename.
+func parsePositionalFileArg(arg string) FileSpec {
+	name := ""
+	rest := arg
+
+	if eq := strings.IndexByte(arg, '='); eq >= 0 {
+		candidate := arg[eq+1:]
+		if candidate != "-" && strings.Contains(candidate, ":") {
+			name, rest = arg[:eq], candidate
+		}
+	}
+
+	if rest != "-" {
+		if colon := strings.IndexByte(rest, ':'); colon >= 0 && !looksLikeWindowsDrivePath(rest, colon) {
+			return FileSpec{Rev: rest[:colon], Path: rest[colon+1:], Name: name}
+		}
+	}
+
+	return FileSpec{Path: rest, Name: name}
+}
+
+// looksLikeWindowsDrivePath reports whether rest is a Windows absolute path
+// like `C:\Users\x\file.txt` rather than a "rev:path" argument: a single
+// drive letter immediately followed by a path separator.
+func looksLikeWindowsDrivePath(rest string, colon int) bool {
+	if colon != 1 {
+		return false
+	}
+	if c := rest[0]; !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') {
+		return false
+	}
+	return len(rest) > colon+1 && (rest[colon+1] == '\\' || rest[colon+1] == '/')
+}
+
+// resolveGitRevSpecs fills in Content (and Name, when not already set) for
+// every spec that has Rev set, batching all of the reads through a single
+// `git cat-file --batch` process.
+func resolveGitRevSpecs(specs []FileSpec) error {
+	var revSpecs []*FileSpec
+	for i := range specs {
+		if specs[i].Rev != "" {
+			revSpecs = append(revSpecs, &specs[i])
+		}
+	}
+	if len(revSpecs) == 0 {
+		return nil
+	}
+
+	if err := assertInsideGitWorkTree(); err != nil {
+		return err
+	}
+
+	objects := make([]string, len(revSpecs))
+	for i, spec := range revSpecs {
+		objects[i] = fmt.Sprintf("%s:%s", spec.Rev, spec.Path)
+	}
+
+	contents, err := gitCatFileBatch(objects)
+	if err != nil {
+		return err
+	}
+
+	for i, spec := range revSpecs {
+		spec.Content = contents[i]
+		if spec.Name == "" {
+			spec.Name = path.Base(spec.Path)
+		}
+	}
+
+	return nil
+}
+
+func assertInsideGitWorkTree() error {
+	out, err := exec.Command("git", "rev-parse", "--is-inside-work-tree").Output()
+	if err != nil || strings.TrimSpace(string(out)) != "true" {
+		return fmt.Errorf("not inside a git work tree; \"rev:path\" arguments require one")
+	}
+	return nil
+}
+
+// gitCatFileBatch reads each "rev:path" object in objects via a single
+// `git cat-file --batch` process, returning their contents in the same
+// order.
+func gitCatFileBatch(objects []string) ([]string, error) {
+	cmd := exec.Command("git", "cat-file", "--batch")
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for _, obj := range objects {
+			fmt.Fprintln(stdin, obj)
+		}
+		stdin.Close()
+	}()
+
+	reader := bufio.NewReader(stdout)
+	results := make([]string, len(objects))
+	for i, obj := range objects {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", obj, err)
+		}
+		header = strings.TrimSuffix(header, "\n")
+
+		if strings.HasSuffix(header, "missing") {
+			return nil, fmt.Errorf("%s not found", obj)
+		}
+
+		fields := strings.Fields(header)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("unexpected response from git cat-file for %s: %q", obj, header)
+		}
+		size, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("unexpected size from git cat-file for %s: %q", obj, header)
+		}
+
+		content := make([]byte, size)
+		if _, err := io.ReadFull(reader, content); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", obj, err)
+		}
+		if _, err := reader.Discard(1); err != nil { // trailing newline after the object
+			return nil, err
+		}
+
+		results[i] = string(content)
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return nil, fmt.Errorf("git cat-file --batch failed: %w", err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestParsePositionalFileArg(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want FileSpec
+	}{
+		{
+			name: "plain path",
+			arg:  "hello.py",
+			want: FileSpec{Path: "hello.py"},
+		},
+		{
+			name: "stdin",
+			arg:  "-",
+			want: FileSpec{Path: "-"},
+		},
+		{
+			name: "rev and path",
+			arg:  "HEAD~3:main.go",
+			want: FileSpec{Rev: "HEAD~3", Path: "main.go"},
+		},
+		{
+			name: "named rev and path",
+			arg:  "old.go=HEAD~3:main.go",
+			want: FileSpec{Rev: "HEAD~3", Path: "main.go", Name: "old.go"},
+		},
+		{
+			name: "windows drive path is not a rev",
+			arg:  `C:\Users\x\file.txt`,
+			want: FileSpec{Path: `C:\Users\x\file.txt`},
+		},
+		{
+			name: "windows drive path with forward slashes is not a rev",
+			arg:  "C:/Users/x/file.txt",
+			want: FileSpec{Path: "C:/Users/x/file.txt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parsePositionalFileArg(tt.arg); got != tt.want {
+				t.Errorf("parsePositionalFileArg(%q) = %+v, want %+v", tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitCatFileBatch(t *testing.T) {
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	run("init")
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	run("add", "main.go")
+	run("commit", "-m", "initial")
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	if err := assertInsideGitWorkTree(); err != nil {
+		t.Fatalf("assertInsideGitWorkTree: %v", err)
+	}
+
+	got, err := gitCatFileBatch([]string{"HEAD:main.go"})
+	if err != nil {
+		t.Fatalf("gitCatFileBatch: %v", err)
+	}
+	if len(got) != 1 || got[0] != "package main\n" {
+		t.Errorf("gitCatFileBatch() = %v, want [%q]", got, "package main\n")
+	}
+
+	if _, err := gitCatFileBatch([]string{"HEAD:does-not-exist.go"}); err == nil {
+		t.Fatal("expected an error for a missing object")
+	}
+}
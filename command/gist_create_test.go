@@ -0,0 +1,96 @@
+package command
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadManifest(t *testing.T) {
+	dir := t.TempDir()
+	manifestPath := filepath.Join(dir, "gist.yml")
+	manifest := `
+description: from manifest
+public: true
+files:
+  - name: hello.py
+    content: "print('hello')\n"
+  - path: ` + filepath.Join(dir, "world.txt") + `
+    language: text
+`
+	if err := ioutil.WriteFile(manifestPath, []byte(manifest), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "world.txt"), []byte("hello world\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	opts, specs, err := loadManifest(manifestPath, &Opts{Description: "fallback", Public: false})
+	if err != nil {
+		t.Fatalf("loadManifest: %v", err)
+	}
+
+	if opts.Description != "from manifest" {
+		t.Errorf("Description = %q, want %q", opts.Description, "from manifest")
+	}
+	if !opts.Public {
+		t.Errorf("Public = false, want true")
+	}
+	if len(specs) != 2 {
+		t.Fatalf("len(specs) = %d, want 2", len(specs))
+	}
+	if specs[0].Name != "hello.py" || specs[0].Content == "" {
+		t.Errorf("specs[0] = %+v, want inline hello.py content", specs[0])
+	}
+	if specs[1].Language != "text" {
+		t.Errorf("specs[1].Language = %q, want %q", specs[1].Language, "text")
+	}
+}
+
+func TestLoadManifest_noFiles(t *testing.T) {
+	dir := t.TempDir()
+	manifestPath := filepath.Join(dir, "gist.yml")
+	if err := ioutil.WriteFile(manifestPath, []byte("description: empty\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := loadManifest(manifestPath, &Opts{}); err == nil {
+		t.Fatal("expected an error for a manifest with no files")
+	}
+}
+
+func TestResolveFileSpecs(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "hello.txt")
+	if err := ioutil.WriteFile(filePath, []byte("hello\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	specs := []FileSpec{
+		{Path: filePath},
+		{Name: "inline.txt", Content: "already here"},
+	}
+
+	resolved, err := resolveFileSpecs(os.Stdin, specs)
+	if err != nil {
+		t.Fatalf("resolveFileSpecs: %v", err)
+	}
+
+	if resolved[0].Name != "hello.txt" || resolved[0].Content != "hello\n" {
+		t.Errorf("resolved[0] = %+v", resolved[0])
+	}
+	if resolved[1].Content != "already here" {
+		t.Errorf("resolved[1] = %+v", resolved[1])
+	}
+}
+
+func TestResolveFileSpecs_inlineContentRequiresName(t *testing.T) {
+	specs := []FileSpec{{Content: "no name"}}
+
+	_, err := resolveFileSpecs(os.Stdin, specs)
+	if err == nil || !strings.Contains(err.Error(), "name is required") {
+		t.Fatalf("err = %v, want a 'name is required' error", err)
+	}
+}
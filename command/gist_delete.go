@@ -0,0 +1,33 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var gistDeleteCmd = &cobra.Command{
+	Use:   "delete {<id> | <url>}",
+	Short: "Delete a gist",
+	Args:  cobra.ExactArgs(1),
+	RunE:  gistDelete,
+}
+
+func gistDelete(cmd *cobra.Command, args []string) error {
+	ctx := contextForCommand(cmd)
+	client, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	gistID := gistIDFromArg(args[0])
+	err = api.GistDelete(client, gistID)
+	if err != nil {
+		return fmt.Errorf("failed to delete gist %s: %w", gistID, err)
+	}
+
+	fmt.Fprintf(colorableErr(cmd), "%s Deleted gist %s\n", utils.Green("✓"), gistID)
+	return nil
+}
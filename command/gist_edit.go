@@ -0,0 +1,181 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var gistEditCmd = &cobra.Command{
+	Use:   "edit {<id> | <url>}",
+	Short: "Edit a gist",
+	Long: `Edit one or more files in a gist.
+
+Without '--add', '--remove' or '--desc', each of the gist's existing files is opened in
+'$EDITOR' and, if changed, the new contents are pushed back to GitHub.`,
+	Args: cobra.ExactArgs(1),
+	RunE: gistEdit,
+}
+
+func gistEdit(cmd *cobra.Command, args []string) error {
+	ctx := contextForCommand(cmd)
+	client, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	gist, err := resolveGist(client, args[0])
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString("desc")
+	if err != nil {
+		return err
+	}
+
+	toAdd, err := cmd.Flags().GetStringArray("add")
+	if err != nil {
+		return err
+	}
+	toRemove, err := cmd.Flags().GetStringArray("remove")
+	if err != nil {
+		return err
+	}
+
+	files := map[string]*api.GistFile{}
+
+	for _, name := range toRemove {
+		if _, ok := gist.Files[name]; !ok {
+			return fmt.Errorf("gist %s has no file named %q", gist.ID, name)
+		}
+		files[name] = nil
+	}
+
+	for _, localPath := range toAdd {
+		content, err := ioutil.ReadFile(localPath)
+		if err != nil {
+			return fmt.Errorf("failed to read file %s: %w", localPath, err)
+		}
+		files[path.Base(localPath)] = &api.GistFile{Content: string(content)}
+	}
+
+	if len(toAdd) == 0 && len(toRemove) == 0 && !cmd.Flags().Changed("desc") {
+		files, err = editGistFilesInPlace(gist)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(files) == 0 && description == "" {
+		return nil
+	}
+
+	_, err = api.GistUpdate(client, gist.ID, description, files)
+	if err != nil {
+		return fmt.Errorf("failed to update gist: %w", err)
+	}
+
+	fmt.Fprintf(colorableErr(cmd), "%s Edited gist %s\n", utils.Green("✓"), gist.ID)
+	return nil
+}
+
+// editGistFilesInPlace opens every file of gist in $EDITOR and returns the
+// files whose contents changed, ready to be sent to api.GistUpdate.
+func editGistFilesInPlace(gist *api.Gist) (map[string]*api.GistFile, error) {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vi"
+	}
+
+	editorArgs, err := splitEditorCmd(editor)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse $EDITOR %q: %w", editor, err)
+	}
+
+	changed := map[string]*api.GistFile{}
+	for name, file := range gist.Files {
+		tmpfile, err := ioutil.TempFile("", fmt.Sprintf("gh-gist*-%s", name))
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(tmpfile.Name())
+
+		if _, err := tmpfile.WriteString(file.Content); err != nil {
+			return nil, err
+		}
+		if err := tmpfile.Close(); err != nil {
+			return nil, err
+		}
+
+		editCmd := exec.Command(editorArgs[0], append(editorArgs[1:], tmpfile.Name())...)
+		editCmd.Stdin = os.Stdin
+		editCmd.Stdout = os.Stdout
+		editCmd.Stderr = os.Stderr
+		if err := editCmd.Run(); err != nil {
+			return nil, fmt.Errorf("failed to run editor %q: %w", strings.TrimSpace(editor), err)
+		}
+
+		newContent, err := ioutil.ReadFile(tmpfile.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		if string(newContent) != file.Content {
+			changed[name] = &api.GistFile{Content: string(newContent)}
+		}
+	}
+
+	return changed, nil
+}
+
+// splitEditorCmd splits an $EDITOR-style value ("code --wait", "vim -c 'set
+// ft=go'") into argv, so arguments aren't swallowed into the binary name
+// passed to exec.Command. Single and double quotes group arguments that
+// contain spaces; there is no escaping support beyond that.
+func splitEditorCmd(editor string) ([]string, error) {
+	var args []string
+	var cur strings.Builder
+	var quote rune
+
+	for _, r := range editor {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				cur.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case unicode.IsSpace(r):
+			if cur.Len() > 0 {
+				args = append(args, cur.String())
+				cur.Reset()
+			}
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unmatched %c quote", quote)
+	}
+	if cur.Len() > 0 {
+		args = append(args, cur.String())
+	}
+
+	if len(args) == 0 {
+		return nil, errors.New("empty editor command")
+	}
+
+	return args, nil
+}
@@ -0,0 +1,128 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var gistListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List your gists",
+	Long:  `List the gists owned by the currently authenticated user.`,
+	RunE:  gistList,
+}
+
+func gistList(cmd *cobra.Command, args []string) error {
+	ctx := contextForCommand(cmd)
+	client, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	limit, err := cmd.Flags().GetInt("limit")
+	if err != nil {
+		return err
+	}
+
+	publicOnly, err := cmd.Flags().GetBool("public")
+	if err != nil {
+		return err
+	}
+	secretOnly, err := cmd.Flags().GetBool("secret")
+	if err != nil {
+		return err
+	}
+	if publicOnly && secretOnly {
+		return fmt.Errorf("specify only one of `--public` or `--secret`")
+	}
+
+	gists, err := fetchGists(client, limit, publicOnly, secretOnly)
+	if err != nil {
+		return fmt.Errorf("failed to list gists: %w", err)
+	}
+
+	if len(gists) == 0 {
+		return fmt.Errorf("no gists found")
+	}
+
+	out := colorableOut(cmd)
+	isTTY := utils.IsTerminal(cmd.OutOrStdout())
+	table := utils.NewTablePrinter(out)
+
+	for _, gist := range gists {
+		visibility := "secret"
+		if gist.Public {
+			visibility = "public"
+		}
+
+		description := gist.Description
+		if description == "" {
+			description = gistFileSummary(gist)
+		}
+
+		table.AddField(gist.ID, nil, nil)
+		table.AddField(description, nil, nil)
+		table.AddField(visibility, nil, nil)
+		if isTTY {
+			table.AddField(utils.FuzzyAgo(gist.UpdatedAt), nil, nil)
+		} else {
+			table.AddField(gist.UpdatedAt.String(), nil, nil)
+		}
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+// gistPageSize is how many gists are requested per page while paging
+// through api.GistList in search of `limit` gists matching the requested
+// visibility.
+const gistPageSize = 100
+
+// fetchGists collects up to limit gists matching the requested visibility
+// filter, paging through api.GistList as needed since the Gists API can't
+// filter by visibility itself.
+func fetchGists(client *api.Client, limit int, publicOnly, secretOnly bool) ([]api.Gist, error) {
+	var matches []api.Gist
+
+	for page := 1; len(matches) < limit; page++ {
+		gists, err := api.GistList(client, page, gistPageSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(gists) == 0 {
+			break
+		}
+
+		for _, gist := range gists {
+			if publicOnly && !gist.Public {
+				continue
+			}
+			if secretOnly && gist.Public {
+				continue
+			}
+			matches = append(matches, gist)
+			if len(matches) == limit {
+				break
+			}
+		}
+
+		if len(gists) < gistPageSize {
+			break
+		}
+	}
+
+	return matches, nil
+}
+
+// gistFileSummary produces a short fallback label from a gist's file names
+// when the gist itself has no description, similar to GitHub's own UI.
+func gistFileSummary(gist api.Gist) string {
+	for name := range gist.Files {
+		return name
+	}
+	return ""
+}
@@ -0,0 +1,45 @@
+package command
+
+import "testing"
+
+func TestGistIDFromArg(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want string
+	}{
+		{
+			name: "bare id",
+			arg:  "1234567890abcdef1234567890abcdef",
+			want: "1234567890abcdef1234567890abcdef",
+		},
+		{
+			name: "gist url",
+			arg:  "https://gist.github.com/1234567890abcdef1234567890abcdef",
+			want: "1234567890abcdef1234567890abcdef",
+		},
+		{
+			name: "gist url with username",
+			arg:  "https://gist.github.com/octocat/1234567890abcdef1234567890abcdef",
+			want: "1234567890abcdef1234567890abcdef",
+		},
+		{
+			name: "gist url with trailing path",
+			arg:  "https://gist.github.com/octocat/1234567890abcdef1234567890abcdef#file-hello-py",
+			want: "1234567890abcdef1234567890abcdef",
+		},
+		{
+			name: "not a gist url",
+			arg:  "https://github.com/cli/cli",
+			want: "https://github.com/cli/cli",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gistIDFromArg(tt.arg); got != tt.want {
+				t.Errorf("gistIDFromArg(%q) = %q, want %q", tt.arg, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+var gistViewCmd = &cobra.Command{
+	Use:   "view {<id> | <url>} [filename]",
+	Short: "View a gist",
+	Long: `View the contents of a gist.
+
+Without a filename argument, the command prints a file listing when the gist
+contains more than one file, or the contents of the single file otherwise.
+
+Note: file contents are currently printed plain, without syntax highlighting;
+'gh gist view' bolds the filename header on a TTY but does not colorize code.`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: gistView,
+}
+
+func gistView(cmd *cobra.Command, args []string) error {
+	ctx := contextForCommand(cmd)
+	client, err := apiClientForContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	gist, err := resolveGist(client, args[0])
+	if err != nil {
+		return err
+	}
+
+	raw, err := cmd.Flags().GetBool("raw")
+	if err != nil {
+		return err
+	}
+
+	filename := ""
+	if len(args) > 1 {
+		filename = args[1]
+	}
+
+	out := colorableOut(cmd)
+	isTTY := utils.IsTerminal(cmd.OutOrStdout())
+
+	if filename == "" && len(gist.Files) > 1 {
+		return listGistFiles(cmd, gist)
+	}
+
+	var files []string
+	if filename != "" {
+		if _, ok := gist.Files[filename]; !ok {
+			return fmt.Errorf("gist %s does not have a file named %q", gist.ID, filename)
+		}
+		files = []string{filename}
+	} else {
+		for name := range gist.Files {
+			files = append(files, name)
+		}
+	}
+	sort.Strings(files)
+
+	for i, name := range files {
+		file := gist.Files[name]
+		if isTTY && !raw {
+			fmt.Fprintf(out, "%s\n\n", utils.Bold(name))
+		}
+		// NOTE: no syntax highlighting is applied here; this repo doesn't
+		// currently vendor a highlighter, so file.Content is printed as-is.
+		fmt.Fprint(out, file.Content)
+		if !strings.HasSuffix(file.Content, "\n") {
+			fmt.Fprint(out, "\n")
+		}
+		if isTTY && !raw && i < len(files)-1 {
+			fmt.Fprint(out, "\n")
+		}
+	}
+
+	return nil
+}
+
+func listGistFiles(cmd *cobra.Command, gist *api.Gist) error {
+	out := colorableOut(cmd)
+	isTTY := utils.IsTerminal(cmd.OutOrStdout())
+	table := utils.NewTablePrinter(out)
+
+	var names []string
+	for name := range gist.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		file := gist.Files[name]
+		table.AddField(name, nil, nil)
+		if isTTY {
+			table.AddField(file.Language, nil, nil)
+		}
+		table.EndRow()
+	}
+
+	return table.Render()
+}
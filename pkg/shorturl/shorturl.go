@@ -0,0 +1,75 @@
+// Package shorturl provides pluggable URL-shortening backends for commands
+// that want to offer a shortened link alongside a canonical one.
+package shorturl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// URLShortener shortens a single URL.
+type URLShortener interface {
+	Shorten(longURL string) (string, error)
+}
+
+// providers is the registry of named shorteners, keyed by the name used for
+// the `gist_shortener` config setting.
+var providers = map[string]func(endpoint string) URLShortener{
+	"git.io": func(endpoint string) URLShortener { return &gitIO{endpoint: endpoint} },
+}
+
+// Register adds or replaces a named shortener, so callers outside this
+// package can plug in additional providers.
+func Register(name string, ctor func(endpoint string) URLShortener) {
+	providers[name] = ctor
+}
+
+// New returns the shortener registered under name, using endpoint as its API
+// base when non-empty so that users can point at a self-hosted,
+// git.io-compatible service. name defaults to "git.io" when empty.
+func New(name, endpoint string) (URLShortener, error) {
+	if name == "" {
+		name = "git.io"
+	}
+	ctor, ok := providers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown URL shortener %q", name)
+	}
+	return ctor(endpoint), nil
+}
+
+const defaultGitIOEndpoint = "https://git.io"
+
+type gitIO struct {
+	endpoint string
+}
+
+// Shorten asks git.io (or a compatible endpoint) to shorten longURL. git.io
+// accepts a `url=` form POST and returns the short link in the Location
+// header of its response, without following the redirect.
+func (g *gitIO) Shorten(longURL string) (string, error) {
+	endpoint := g.endpoint
+	if endpoint == "" {
+		endpoint = defaultGitIOEndpoint
+	}
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.PostForm(endpoint, url.Values{"url": {longURL}})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if loc := resp.Header.Get("Location"); loc != "" {
+		return loc, nil
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	return "", fmt.Errorf("%s did not return a short URL: %s", endpoint, string(body))
+}
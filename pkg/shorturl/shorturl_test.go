@@ -0,0 +1,63 @@
+package shorturl
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNew_unknownProvider(t *testing.T) {
+	if _, err := New("not-a-real-provider", ""); err == nil {
+		t.Fatal("expected an error for an unregistered provider")
+	}
+}
+
+func TestNew_defaultsToGitIO(t *testing.T) {
+	s, err := New("", "")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if _, ok := s.(*gitIO); !ok {
+		t.Errorf("New(\"\", \"\") = %T, want *gitIO", s)
+	}
+}
+
+func TestGitIO_Shorten(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if got := r.FormValue("url"); got != "https://gist.github.com/abc123" {
+			t.Errorf("url = %q, want %q", got, "https://gist.github.com/abc123")
+		}
+		w.Header().Set("Location", "https://git.io/short")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	s, err := New("git.io", srv.URL)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	short, err := s.Shorten("https://gist.github.com/abc123")
+	if err != nil {
+		t.Fatalf("Shorten: %v", err)
+	}
+	if short != "https://git.io/short" {
+		t.Errorf("Shorten() = %q, want %q", short, "https://git.io/short")
+	}
+}
+
+func TestGitIO_Shorten_noLocationHeader(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("nope"))
+	}))
+	defer srv.Close()
+
+	s, _ := New("git.io", srv.URL)
+	if _, err := s.Shorten("https://gist.github.com/abc123"); err == nil {
+		t.Fatal("expected an error when the server returns no Location header")
+	}
+}